@@ -0,0 +1,69 @@
+package gcp
+
+// cloudFunctionRuntimeLifecycle describes what's known about a Cloud
+// Functions runtime's end-of-life schedule.
+type cloudFunctionRuntimeLifecycle struct {
+	Deprecated       bool
+	DecommissionDate string // RFC3339 date, e.g. "2024-01-30", empty if not yet announced
+}
+
+// cloudFunctionRuntimeCatalog is a maintained map of Cloud Functions runtime
+// id to its deprecation/decommission status, sourced from the same runtime
+// support schedule the Terraform provider uses when picking a default
+// runtime: https://cloud.google.com/functions/docs/runtime-support
+//
+// Update procedure: when Google publishes a new deprecation or decommission
+// date on that page, add/update the entry here with the runtime id exactly
+// as it appears in the Cloud Functions API (the `runtime` field) and its
+// decommission date in RFC3339 date form. Runtimes with an announced but
+// future decommission date should still be marked Deprecated: true once
+// Google has flagged them as deprecated, even if the hard decommission date
+// hasn't passed yet.
+var cloudFunctionRuntimeCatalog = map[string]cloudFunctionRuntimeLifecycle{
+	"nodejs6":   {Deprecated: true, DecommissionDate: "2020-09-30"},
+	"nodejs8":   {Deprecated: true, DecommissionDate: "2021-03-03"},
+	"nodejs10":  {Deprecated: true, DecommissionDate: "2022-01-30"},
+	"nodejs12":  {Deprecated: true, DecommissionDate: "2023-04-30"},
+	"nodejs14":  {Deprecated: true, DecommissionDate: "2024-04-30"},
+	"nodejs16":  {Deprecated: true, DecommissionDate: "2024-10-15"},
+	"nodejs18":  {Deprecated: false},
+	"nodejs20":  {Deprecated: false},
+	"python37":  {Deprecated: true, DecommissionDate: "2023-09-30"},
+	"python38":  {Deprecated: true, DecommissionDate: "2024-10-15"},
+	"python39":  {Deprecated: false},
+	"python310": {Deprecated: false},
+	"python311": {Deprecated: false},
+	"python312": {Deprecated: false},
+	"go111":     {Deprecated: true, DecommissionDate: "2021-01-30"},
+	"go113":     {Deprecated: true, DecommissionDate: "2022-01-30"},
+	"go116":     {Deprecated: true, DecommissionDate: "2023-01-30"},
+	"go118":     {Deprecated: true, DecommissionDate: "2024-01-30"},
+	"go119":     {Deprecated: false},
+	"go120":     {Deprecated: false},
+	"go121":     {Deprecated: false},
+	"java11":    {Deprecated: false},
+	"java17":    {Deprecated: false},
+	"dotnet3":   {Deprecated: true, DecommissionDate: "2023-03-01"},
+	"dotnet6":   {Deprecated: false},
+	"ruby26":    {Deprecated: true, DecommissionDate: "2022-03-30"},
+	"ruby27":    {Deprecated: true, DecommissionDate: "2023-09-30"},
+	"ruby30":    {Deprecated: false},
+	"ruby32":    {Deprecated: false},
+	"php74":     {Deprecated: true, DecommissionDate: "2023-03-01"},
+	"php81":     {Deprecated: false},
+	"php82":     {Deprecated: false},
+}
+
+// runtimeDeprecated looks up whether the given Cloud Functions runtime id is
+// deprecated. Unknown runtimes (not yet added to the catalog) are treated as
+// not deprecated rather than erroring, since the catalog only tracks what's
+// been explicitly announced.
+func runtimeDeprecated(runtime string) bool {
+	return cloudFunctionRuntimeCatalog[runtime].Deprecated
+}
+
+// runtimeDecommissionDate returns the RFC3339 decommission date for the given
+// Cloud Functions runtime id, or "" if none has been announced.
+func runtimeDecommissionDate(runtime string) string {
+	return cloudFunctionRuntimeCatalog[runtime].DecommissionDate
+}
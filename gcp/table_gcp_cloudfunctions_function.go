@@ -22,6 +22,9 @@ func tableGcpCloudfunctionFunction(ctx context.Context) *plugin.Table {
 			Hydrate:    getCloudFunction,
 		},
 		List: &plugin.ListConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "location", Require: plugin.Optional},
+			},
 			Hydrate: listCloudFunctions,
 		},
 		Columns: []*plugin.Column{
@@ -46,6 +49,18 @@ func tableGcpCloudfunctionFunction(ctx context.Context) *plugin.Table {
 				Description: "The runtime in which to run the function.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "runtime_deprecated",
+				Description: "True if the function's runtime has been deprecated by Google.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Runtime").Transform(transformRuntimeDeprecated),
+			},
+			{
+				Name:        "runtime_decommission_date",
+				Description: "The date the function's runtime is (or will be) decommissioned by Google.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Runtime").Transform(transformRuntimeDecommissionDate),
+			},
 
 			// other columns
 			{
@@ -78,11 +93,47 @@ func tableGcpCloudfunctionFunction(ctx context.Context) *plugin.Table {
 				Description: "A source that fires events in response to a condition in another service.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "event_trigger_type",
+				Description: "The type of event to observe, e.g. `google.pubsub.topic.publish` or `google.storage.object.finalize`.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(eventTriggerType),
+			},
+			{
+				Name:        "event_trigger_resource",
+				Description: "The resource(s) from which to observe events, for example, `projects/_/buckets/myBucket`.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(eventTriggerResource),
+			},
+			{
+				Name:        "event_trigger_service",
+				Description: "The hostname of the service that should be observed, e.g. `pubsub.googleapis.com`.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(eventTriggerService),
+			},
+			{
+				Name:        "event_trigger_retry_on_failure",
+				Description: "True if the function should be retried on failure, derived from `event_trigger.failure_policy.retry`.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(eventTriggerRetryOnFailure),
+			},
 			{
 				Name:        "https_trigger",
 				Description: "An HTTPS endpoint type of source that can be triggered via URL.",
 				Type:        proto.ColumnType_JSON,
 			},
+			{
+				Name:        "https_trigger_url",
+				Description: "The deployed url for the HTTPS trigger, derived from `https_trigger.url`.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(httpsTriggerURL),
+			},
+			{
+				Name:        "https_trigger_security_level",
+				Description: "The security level of the HTTPS trigger (SECURE_ALWAYS, SECURE_OPTIONAL), derived from `https_trigger.security_level`.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(httpsTriggerSecurityLevel),
+			},
 			{
 				Name:        "iam_policy",
 				Description: "The IAM policy for the function.", Transform: transform.FromValue(), Hydrate: getGcpCloudFunctionIamPolicy,
@@ -203,10 +254,18 @@ func listCloudFunctions(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	}
 
 	project := activeProject()
-	data := "projects/" + project + "/locations/-" // '-' for all locations...
 
-	resp := service.Projects.Locations.Functions.List(data)
-	if err := resp.Pages(
+	// '-' lists all locations in one call. When the qualifier manager has
+	// scoped the query to a single location, query that location directly
+	// instead - it's a strict subset of the wildcard call and cheaper.
+	location := "-"
+	if d.KeyColumnQuals["location"] != nil {
+		location = d.KeyColumnQuals["location"].GetStringValue()
+	}
+	parent := "projects/" + project + "/locations/" + location
+
+	resp := service.Projects.Locations.Functions.List(parent)
+	return nil, resp.Pages(
 		ctx,
 		func(page *cloudfunctions.ListFunctionsResponse) error {
 			for _, item := range page.Functions {
@@ -214,11 +273,7 @@ func listCloudFunctions(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 			}
 			return nil
 		},
-	); err != nil {
-		return nil, err
-	}
-
-	return nil, nil
+	)
 }
 
 func getCloudFunction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
@@ -276,6 +331,69 @@ func functionAka(_ context.Context, d *transform.TransformData) (interface{}, er
 
 }
 
+func eventTriggerType(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	i := d.HydrateItem.(*cloudfunctions.CloudFunction)
+	if i.EventTrigger == nil {
+		return nil, nil
+	}
+	return i.EventTrigger.EventType, nil
+}
+
+func eventTriggerResource(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	i := d.HydrateItem.(*cloudfunctions.CloudFunction)
+	if i.EventTrigger == nil {
+		return nil, nil
+	}
+	return i.EventTrigger.Resource, nil
+}
+
+func eventTriggerService(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	i := d.HydrateItem.(*cloudfunctions.CloudFunction)
+	if i.EventTrigger == nil {
+		return nil, nil
+	}
+	return i.EventTrigger.Service, nil
+}
+
+func eventTriggerRetryOnFailure(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	i := d.HydrateItem.(*cloudfunctions.CloudFunction)
+	if i.EventTrigger == nil {
+		return nil, nil
+	}
+	if i.EventTrigger.FailurePolicy == nil {
+		return false, nil
+	}
+	return i.EventTrigger.FailurePolicy.Retry != nil, nil
+}
+
+func httpsTriggerURL(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	i := d.HydrateItem.(*cloudfunctions.CloudFunction)
+	if i.HttpsTrigger == nil {
+		return nil, nil
+	}
+	return i.HttpsTrigger.Url, nil
+}
+
+func httpsTriggerSecurityLevel(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	i := d.HydrateItem.(*cloudfunctions.CloudFunction)
+	if i.HttpsTrigger == nil {
+		return nil, nil
+	}
+	return i.HttpsTrigger.SecurityLevel, nil
+}
+
+func transformRuntimeDeprecated(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	return runtimeDeprecated(types.SafeString(d.Value)), nil
+}
+
+func transformRuntimeDecommissionDate(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	date := runtimeDecommissionDate(types.SafeString(d.Value))
+	if date == "" {
+		return nil, nil
+	}
+	return date, nil
+}
+
 func locationFromFunctionName(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	functionName := types.SafeString(d.Value)
 	parts := strings.Split(functionName, "/")
@@ -0,0 +1,181 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+
+	"google.golang.org/api/cloudfunctions/v1"
+	cloudfunctionsv2 "google.golang.org/api/cloudfunctions/v2"
+)
+
+// cloudFunctionAllRow is the shape streamed by gcp_cloudfunctions_function_all,
+// a thin union of the Gen 1 and Gen 2 function resources so both generations
+// can be queried/joined without a client having to know which API produced a row.
+type cloudFunctionAllRow struct {
+	Name        string
+	Environment string
+	Status      string
+	Runtime     string
+	Labels      map[string]string
+	UpdateTime  string
+}
+
+func tableGcpCloudfunctionFunctionAll(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloudfunctions_function_all",
+		Description: "GCP Cloud Function (Gen 1 and Gen 2, unioned)",
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFunctionsAll,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "environment",
+				Description: "The Cloud Functions environment the function is hosted on (GEN_1, GEN_2).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "status",
+				Description: "Status of the function deployment.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "runtime",
+				Description: "The runtime in which to run the function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "update_time",
+				Description: "The last update timestamp of the Cloud Function.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(functionAllAka),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant(activeProject()),
+			},
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(locationFromFunctionName),
+			},
+		},
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+// listCloudFunctionsAll unions gcp_cloudfunctions_function (Gen 1) and
+// gcp_cloudfunctions_function_v2 (Gen 2) so both generations can be queried
+// through a single table.
+func listCloudFunctionsAll(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("listCloudFunctionsAll")
+
+	project := activeProject()
+
+	v1Service, err := cloudfunctions.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v1Resp := v1Service.Projects.Locations.Functions.List("projects/" + project + "/locations/-")
+	if err := v1Resp.Pages(
+		ctx,
+		func(page *cloudfunctions.ListFunctionsResponse) error {
+			for _, item := range page.Functions {
+				d.StreamListItem(ctx, &cloudFunctionAllRow{
+					Name:        item.Name,
+					Environment: "GEN_1",
+					Status:      item.Status,
+					Runtime:     item.Runtime,
+					Labels:      item.Labels,
+					UpdateTime:  item.UpdateTime,
+				})
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	v2Service, err := cloudfunctionsv2.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v2Resp := v2Service.Projects.Locations.Functions.List("projects/" + project + "/locations/-")
+	if err := v2Resp.Pages(
+		ctx,
+		func(page *cloudfunctionsv2.ListFunctionsResponse) error {
+			for _, item := range page.Functions {
+				// Gen 1 functions were already streamed by the v1 pass above.
+				if item.Environment == "GEN_1" {
+					continue
+				}
+				var runtime string
+				if item.BuildConfig != nil {
+					runtime = item.BuildConfig.Runtime
+				}
+				d.StreamListItem(ctx, &cloudFunctionAllRow{
+					Name:        item.Name,
+					Environment: "GEN_2",
+					Status:      item.State,
+					Runtime:     runtime,
+					Labels:      item.Labels,
+					UpdateTime:  item.UpdateTime,
+				})
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func functionAllAka(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	i := d.HydrateItem.(*cloudFunctionAllRow)
+
+	functionNamePath := types.SafeString(i.Name)
+
+	//ex: gcp://cloudfunctions.googleapis.com/projects/project-aaa/locations/us-central1/functions/hello-world
+	akas := []string{"gcp://cloudfunctions.googleapis.com/" + functionNamePath}
+
+	return akas, nil
+}
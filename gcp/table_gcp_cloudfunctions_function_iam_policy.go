@@ -0,0 +1,331 @@
+package gcp
+
+import (
+	"context"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+
+	"google.golang.org/api/cloudfunctions/v1"
+	cloudfunctionsv2 "google.golang.org/api/cloudfunctions/v2"
+)
+
+// cloudFunctionIamPolicyBinding is a single (function, role, member) triple
+// flattened out of a Cloud Functions IAM policy, one per row.
+type cloudFunctionIamPolicyBinding struct {
+	FunctionName        string
+	Location            string
+	Role                string
+	Member              string
+	MemberType          string
+	ConditionTitle      *string
+	ConditionExpression *string
+	Etag                string
+	Version             int64
+}
+
+// cloudFunctionIamPolicy is a generation-agnostic view of a Cloud Functions
+// IAM policy, normalized from either the v1 or v2 API's Policy type so the
+// binding-flattening code below doesn't need to know which generation a
+// function belongs to.
+type cloudFunctionIamPolicy struct {
+	Etag     string
+	Version  int64
+	Bindings []cloudFunctionIamPolicyBindingGroup
+}
+
+type cloudFunctionIamPolicyBindingGroup struct {
+	Role                string
+	Members             []string
+	ConditionTitle      *string
+	ConditionExpression *string
+}
+
+func tableGcpCloudfunctionFunctionIamPolicy(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloudfunctions_function_iam_policy",
+		Description: "GCP Cloud Function IAM Policy, flattened to one row per member/role binding.",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.AllColumns([]string{"function_name", "role", "member"}),
+			Hydrate:    getCloudFunctionIamPolicyBinding,
+		},
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "function_name", Require: plugin.Optional},
+			},
+			Hydrate: listCloudFunctionIamPolicyBindings,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "function_name",
+				Description: "The fully qualified name of the function the binding applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionName").Transform(locationFromFunctionName),
+			},
+			{
+				Name:        "role",
+				Description: "The role that is assigned to the member(s).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member",
+				Description: "The identity, e.g. user:alice@example.com, serviceAccount:..., group:..., domain:..., or allUsers, the role is granted to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_type",
+				Description: "The type of the member, parsed from its prefix (user, serviceAccount, group, domain, allUsers, allAuthenticatedUsers).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "condition_title",
+				Description: "The title of the IAM condition attached to the binding, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "condition_expression",
+				Description: "The CEL expression of the IAM condition attached to the binding, if any.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "etag",
+				Description: "The etag of the policy the binding was read from. Used for optimistic concurrency control.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "version",
+				Description: "The version of the policy the binding was read from.",
+				Type:        proto.ColumnType_INT,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("FunctionName"),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant(activeProject()),
+			},
+		},
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+// listCloudFunctionIamPolicyBindings iterates every Cloud Function (Gen 1 and
+// Gen 2) and streams one row per (function, role, member) triple. When
+// function_name is qualified, only that function's policy is fetched so joins
+// from gcp_cloudfunctions_function / gcp_cloudfunctions_function_v2 stay cheap.
+func listCloudFunctionIamPolicyBindings(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("listCloudFunctionIamPolicyBindings")
+
+	v1Service, err := cloudfunctions.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v2Service, err := cloudfunctionsv2.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.KeyColumnQuals["function_name"] != nil {
+		functionName := d.KeyColumnQuals["function_name"].GetStringValue()
+		policy, err := getCloudFunctionIamPolicyAnyGeneration(v1Service, v2Service, functionName)
+		if err != nil {
+			return nil, err
+		}
+		streamCloudFunctionIamPolicyBindings(ctx, d, functionName, policy)
+		return nil, nil
+	}
+
+	project := activeProject()
+
+	v1Resp := v1Service.Projects.Locations.Functions.List("projects/" + project + "/locations/-")
+	if err := v1Resp.Pages(
+		ctx,
+		func(page *cloudfunctions.ListFunctionsResponse) error {
+			for _, item := range page.Functions {
+				policy, err := getCloudFunctionV1IamPolicyNormalized(v1Service, item.Name)
+				if err != nil {
+					return err
+				}
+				streamCloudFunctionIamPolicyBindings(ctx, d, item.Name, policy)
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	v2Resp := v2Service.Projects.Locations.Functions.List("projects/" + project + "/locations/-")
+	if err := v2Resp.Pages(
+		ctx,
+		func(page *cloudfunctionsv2.ListFunctionsResponse) error {
+			for _, item := range page.Functions {
+				// Gen 1 functions were already covered by the v1 pass above.
+				if item.Environment == "GEN_1" {
+					continue
+				}
+				policy, err := getCloudFunctionV2IamPolicyNormalized(v2Service, item.Name)
+				if err != nil {
+					return err
+				}
+				streamCloudFunctionIamPolicyBindings(ctx, d, item.Name, policy)
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// streamCloudFunctionIamPolicyBindings streams one row per member/role pair
+// in the given already-fetched, generation-normalized policy.
+func streamCloudFunctionIamPolicyBindings(ctx context.Context, d *plugin.QueryData, functionName string, policy *cloudFunctionIamPolicy) {
+	for _, binding := range policy.Bindings {
+		for _, member := range binding.Members {
+			d.StreamListItem(ctx, &cloudFunctionIamPolicyBinding{
+				FunctionName:        functionName,
+				Role:                binding.Role,
+				Member:              member,
+				MemberType:          memberType(member),
+				ConditionTitle:      binding.ConditionTitle,
+				ConditionExpression: binding.ConditionExpression,
+				Etag:                policy.Etag,
+				Version:             policy.Version,
+			})
+		}
+	}
+}
+
+func getCloudFunctionIamPolicyBinding(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getCloudFunctionIamPolicyBinding")
+
+	functionName := d.KeyColumnQuals["function_name"].GetStringValue()
+	role := d.KeyColumnQuals["role"].GetStringValue()
+	member := d.KeyColumnQuals["member"].GetStringValue()
+
+	v1Service, err := cloudfunctions.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	v2Service, err := cloudfunctionsv2.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := getCloudFunctionIamPolicyAnyGeneration(v1Service, v2Service, functionName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, binding := range policy.Bindings {
+		if binding.Role != role {
+			continue
+		}
+		for _, m := range binding.Members {
+			if m != member {
+				continue
+			}
+
+			return &cloudFunctionIamPolicyBinding{
+				FunctionName:        functionName,
+				Role:                binding.Role,
+				Member:              m,
+				MemberType:          memberType(m),
+				ConditionTitle:      binding.ConditionTitle,
+				ConditionExpression: binding.ConditionExpression,
+				Etag:                policy.Etag,
+				Version:             policy.Version,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getCloudFunctionIamPolicyAnyGeneration fetches a function's IAM policy
+// without knowing in advance whether it's a Gen 1 or Gen 2 function. The v1
+// and v2 Functions APIs don't share an IAM surface - a v1 GetIamPolicy call
+// against a Gen 2 function's resource name fails - so v1 is tried first and
+// v2 is only used as a fallback.
+func getCloudFunctionIamPolicyAnyGeneration(v1Service *cloudfunctions.Service, v2Service *cloudfunctionsv2.Service, functionName string) (*cloudFunctionIamPolicy, error) {
+	policy, v1Err := getCloudFunctionV1IamPolicyNormalized(v1Service, functionName)
+	if v1Err == nil {
+		return policy, nil
+	}
+
+	policy, v2Err := getCloudFunctionV2IamPolicyNormalized(v2Service, functionName)
+	if v2Err != nil {
+		return nil, v1Err
+	}
+	return policy, nil
+}
+
+func getCloudFunctionV1IamPolicyNormalized(service *cloudfunctions.Service, functionName string) (*cloudFunctionIamPolicy, error) {
+	policy, err := service.Projects.Locations.Functions.GetIamPolicy(functionName).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := &cloudFunctionIamPolicy{Etag: policy.Etag, Version: policy.Version}
+	for _, binding := range policy.Bindings {
+		group := cloudFunctionIamPolicyBindingGroup{Role: binding.Role, Members: binding.Members}
+		if binding.Condition != nil {
+			group.ConditionTitle = &binding.Condition.Title
+			group.ConditionExpression = &binding.Condition.Expression
+		}
+		normalized.Bindings = append(normalized.Bindings, group)
+	}
+	return normalized, nil
+}
+
+func getCloudFunctionV2IamPolicyNormalized(service *cloudfunctionsv2.Service, functionName string) (*cloudFunctionIamPolicy, error) {
+	policy, err := service.Projects.Locations.Functions.GetIamPolicy(functionName).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := &cloudFunctionIamPolicy{Etag: policy.Etag, Version: policy.Version}
+	for _, binding := range policy.Bindings {
+		group := cloudFunctionIamPolicyBindingGroup{Role: binding.Role, Members: binding.Members}
+		if binding.Condition != nil {
+			group.ConditionTitle = &binding.Condition.Title
+			group.ConditionExpression = &binding.Condition.Expression
+		}
+		normalized.Bindings = append(normalized.Bindings, group)
+	}
+	return normalized, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// memberType parses the member type prefix off an IAM member string, e.g.
+// "serviceAccount:foo@bar.iam.gserviceaccount.com" -> "serviceAccount".
+// Members without a colon (allUsers, allAuthenticatedUsers) are returned as-is.
+func memberType(member string) string {
+	if idx := strings.Index(member, ":"); idx != -1 {
+		return member[:idx]
+	}
+	return member
+}
@@ -0,0 +1,251 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/plugin/transform"
+
+	cloudfunctionsv2 "google.golang.org/api/cloudfunctions/v2"
+)
+
+func tableGcpCloudfunctionFunctionV2(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "gcp_cloudfunctions_function_v2",
+		Description: "GCP Cloud Function (2nd gen)",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getCloudFunctionV2,
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listCloudFunctionsV2,
+		},
+		Columns: []*plugin.Column{
+			// commonly used columns
+			{
+				Name:        "name",
+				Description: "The name of the function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "state",
+				Description: "State of the function (STATE_UNSPECIFIED, ACTIVE, FAILED, DEPLOYING, DELETING, UNKNOWN).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "User-provided description of a function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "environment",
+				Description: "Describes the Cloud Functions environment the function is hosted on (GEN_1, GEN_2).",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// other columns
+			{
+				Name:        "build_config",
+				Description: "Describes the Build step of the function that builds a container from the given source.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "runtime_deprecated",
+				Description: "True if the function's runtime (build_config.runtime) has been deprecated by Google.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.From(functionV2RuntimeDeprecated),
+			},
+			{
+				Name:        "runtime_decommission_date",
+				Description: "The date the function's runtime (build_config.runtime) is (or will be) decommissioned by Google.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.From(functionV2RuntimeDecommissionDate),
+			},
+			{
+				Name:        "service_config",
+				Description: "Describes the Service being deployed, backed by Cloud Run.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "event_trigger",
+				Description: "An Eventarc trigger managed by the Cloud Functions service to invoke the function.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "labels",
+				Description: "Labels associated with this Cloud Function.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "kms_key_name",
+				Description: "Resource name of a KMS crypto key (managed by the user) used to encrypt/decrypt function resources.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "satisfies_pzs",
+				Description: "Whether the function is deployed with zone redundancy enabled, meaning it will run and be deployed in at least 3 zones.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "state_messages",
+				Description: "State messages for this Cloud Function describing the current state in more detail.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "url",
+				Description: "Output only URL of the deployed function.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "iam_policy",
+				Description: "The IAM policy for the function.", Transform: transform.FromValue(), Hydrate: getGcpCloudFunctionV2IamPolicy,
+				Type: proto.ColumnType_JSON,
+			},
+			{
+				Name:        "update_time",
+				Description: "The last update timestamp of the Cloud Function.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// standard steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Labels"),
+			},
+			{
+				Name:        "akas",
+				Description: ColumnDescriptionAkas,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(functionV2Aka),
+			},
+
+			// standard gcp columns
+			{
+				Name:        "project",
+				Description: ColumnDescriptionProject,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromConstant(activeProject()),
+			},
+			{
+				Name:        "location",
+				Description: ColumnDescriptionLocation,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name").Transform(locationFromFunctionName),
+			},
+		},
+	}
+}
+
+//// HYDRATE FUNCTIONS
+
+func listCloudFunctionsV2(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("listCloudFunctionsV2")
+
+	service, err := cloudfunctionsv2.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project := activeProject()
+	parent := "projects/" + project + "/locations/-" // '-' for all locations...
+
+	resp := service.Projects.Locations.Functions.List(parent)
+	if err := resp.Pages(
+		ctx,
+		func(page *cloudfunctionsv2.ListFunctionsResponse) error {
+			for _, item := range page.Functions {
+				d.StreamListItem(ctx, item)
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func getCloudFunctionV2(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getCloudFunctionV2")
+
+	service, err := cloudfunctionsv2.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	name := d.KeyColumnQuals["name"].GetStringValue()
+
+	function, err := service.Projects.Locations.Functions.Get(name).Do()
+	if err != nil {
+		return nil, err
+	}
+	return function, nil
+}
+
+func getGcpCloudFunctionV2IamPolicy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	logger.Trace("getGcpCloudFunctionV2IamPolicy")
+
+	service, err := cloudfunctionsv2.NewService(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	function := h.Item.(*cloudfunctionsv2.Function)
+
+	resp, err := service.Projects.Locations.Functions.GetIamPolicy(function.Name).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp != nil {
+		return resp, nil
+	}
+
+	return cloudfunctionsv2.Policy{}, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+func functionV2RuntimeDeprecated(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	i := d.HydrateItem.(*cloudfunctionsv2.Function)
+	if i.BuildConfig == nil {
+		return false, nil
+	}
+	return runtimeDeprecated(i.BuildConfig.Runtime), nil
+}
+
+func functionV2RuntimeDecommissionDate(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	i := d.HydrateItem.(*cloudfunctionsv2.Function)
+	if i.BuildConfig == nil {
+		return nil, nil
+	}
+	date := runtimeDecommissionDate(i.BuildConfig.Runtime)
+	if date == "" {
+		return nil, nil
+	}
+	return date, nil
+}
+
+func functionV2Aka(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	i := d.HydrateItem.(*cloudfunctionsv2.Function)
+
+	functionNamePath := types.SafeString(i.Name)
+
+	//ex: gcp://cloudfunctions.googleapis.com/projects/project-aaa/locations/us-central1/functions/hello-world
+	akas := []string{"gcp://cloudfunctions.googleapis.com/" + functionNamePath}
+
+	return akas, nil
+}